@@ -0,0 +1,110 @@
+package stripe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/client"
+)
+
+func dataSourceStripeWebhookEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStripeWebhookEndpointRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Unique identifier of the webhook endpoint to look up. Either `id` or `url` must be set.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The URL of the webhook endpoint to look up. Either `id` or `url` must be set.",
+			},
+			"enabled_events": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The list of events this endpoint is enabled for.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An optional description of what the webhook is used for.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the webhook endpoint is disabled.",
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API version events are rendered as for this webhook endpoint.",
+			},
+			"connect": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether this endpoint receives events from connected accounts.",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of key-value pairs attached to the object.",
+			},
+		},
+	}
+}
+
+func dataSourceStripeWebhookEndpointRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.API)
+
+	var webhookEndpoint *stripe.WebhookEndpoint
+
+	if id, set := d.GetOk("id"); set {
+		endpoint, err := c.WebhookEndpoints.Get(ToString(id), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		webhookEndpoint = endpoint
+	} else if url, set := d.GetOk("url"); set {
+		iter := c.WebhookEndpoints.List(nil)
+		for iter.Next() {
+			endpoint := iter.WebhookEndpoint()
+			if endpoint.URL == ToString(url) {
+				webhookEndpoint = endpoint
+				break
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return diag.FromErr(err)
+		}
+		if webhookEndpoint == nil {
+			return diag.FromErr(fmt.Errorf("no stripe_webhook_endpoint found for url %q", ToString(url)))
+		}
+	} else {
+		return diag.FromErr(fmt.Errorf("one of `id` or `url` must be set"))
+	}
+
+	disabled := true
+	if webhookEndpoint.Status == "enabled" {
+		disabled = false
+	}
+
+	d.SetId(webhookEndpoint.ID)
+	return CallSet(
+		d.Set("url", webhookEndpoint.URL),
+		d.Set("enabled_events", webhookEndpoint.EnabledEvents),
+		d.Set("description", webhookEndpoint.Description),
+		d.Set("disabled", disabled),
+		d.Set("api_version", webhookEndpoint.APIVersion),
+		d.Set("connect", webhookEndpoint.Connect),
+		d.Set("metadata", webhookEndpoint.Metadata),
+	)
+}
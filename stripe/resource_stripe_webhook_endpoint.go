@@ -6,16 +6,37 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/stripe/stripe-go/v72"
 	"github.com/stripe/stripe-go/v72/client"
 )
 
+func SetSlice(d *schema.ResourceData, key string) []string {
+	set := d.Get(key).(*schema.Set)
+	slice := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		slice = append(slice, v.(string))
+	}
+	return slice
+}
+
 func resourceStripeWebhookEndpoint() *schema.Resource {
 	return &schema.Resource{
 		ReadContext:   resourceStripeWebhookEndpointRead,
 		CreateContext: resourceStripeWebhookEndpointCreate,
 		UpdateContext: resourceStripeWebhookEndpointUpdate,
 		DeleteContext: resourceStripeWebhookEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceStripeWebhookEndpointResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceStripeWebhookEndpointStateUpgradeV0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -23,12 +44,24 @@ func resourceStripeWebhookEndpoint() *schema.Resource {
 				Description: "Unique identifier for the object.",
 			},
 			"enabled_events": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+					return !Bool(d, "manage_enabled_events")
+				},
 				Description: "The list of events to enable for this endpoint. " +
 					"[’*’] indicates that all events are enabled, except those that require explicit selection.",
 			},
+			"manage_enabled_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Whether this resource manages enabled_events directly. Set to false when one or more " +
+					"stripe_webhook_endpoint_event_subscription resources own disjoint slices of this endpoint's events, " +
+					"so that drift in enabled_events caused by those subscriptions is ignored here.",
+			},
 			"url": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -51,6 +84,53 @@ func resourceStripeWebhookEndpoint() *schema.Resource {
 				Default:     false,
 				Description: "Disable the webhook endpoint if set to true.",
 			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The API version events are rendered as for this webhook endpoint. Defaults to the account's default Stripe API version. Cannot be changed after creation.",
+			},
+			"connect": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether this endpoint should receive events from connected accounts (true), or from your account (false). Cannot be changed after creation.",
+			},
+			"secret_sink": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Description: "When set, the signing secret is delivered to this sink instead of being stored in `secret`. " +
+					"Changing it recreates the endpoint, since the sink is only ever written from Create.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{secretSinkTypeEnv, secretSinkTypeFile, secretSinkTypeExec}, false),
+							Description:  "How to deliver the secret: `env` (set an environment variable for child processes), `file` (write a 0600 file), or `exec` (pipe to the stdin of a command).",
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The env var name, file path, or executable to deliver the secret to, depending on `type`.",
+						},
+					},
+				},
+			},
+			"secret_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the signing secret, set when `secret_sink` is used so the secret itself never enters state.",
+			},
+			"force_rotate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "An arbitrary timestamp. Changing this value deletes and recreates the endpoint to obtain a fresh signing secret and re-runs secret_sink.",
+			},
 			"metadata": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -79,6 +159,8 @@ func resourceStripeWebhookEndpointRead(_ context.Context, d *schema.ResourceData
 		d.Set("url", webhookEndpoint.URL),
 		d.Set("description", webhookEndpoint.Description),
 		d.Set("disabled", disabled),
+		d.Set("api_version", webhookEndpoint.APIVersion),
+		d.Set("connect", webhookEndpoint.Connect),
 		d.Set("metadata", webhookEndpoint.Metadata),
 	)
 }
@@ -92,12 +174,18 @@ func resourceStripeWebhookEndpointCreate(ctx context.Context, d *schema.Resource
 
 	params := &stripe.WebhookEndpointParams{
 		URL:           stripe.String(String(d, "url")),
-		EnabledEvents: stripe.StringSlice(StringSlice(d, "enabled_events")),
+		EnabledEvents: stripe.StringSlice(SetSlice(d, "enabled_events")),
 	}
 
 	if description, set := d.GetOk("description"); set {
 		params.Description = stripe.String(ToString(description))
 	}
+	if apiVersion, set := d.GetOk("api_version"); set {
+		params.APIVersion = stripe.String(ToString(apiVersion))
+	}
+	if connect, set := d.GetOk("connect"); set {
+		params.Connect = stripe.Bool(ToBool(connect))
+	}
 	if meta, set := d.GetOk("metadata"); set {
 		for k, v := range ToMap(meta) {
 			params.AddMetadata(k, ToString(v))
@@ -108,15 +196,27 @@ func resourceStripeWebhookEndpointCreate(ctx context.Context, d *schema.Resource
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	d.SetId(webhookEndpoint.ID)
+
+	secret := webhookEndpoint.Secret
+	fingerprint := ""
+	if sinks, ok := d.GetOk("secret_sink"); ok {
+		sink := sinks.([]interface{})[0].(map[string]interface{})
+		fingerprint, err = writeSecretSink(sink["type"].(string), sink["target"].(string), secret)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		secret = ""
+	}
 
 	dg := CallSet(
-		d.Set("secret", webhookEndpoint.Secret),
+		d.Set("secret", secret),
+		d.Set("secret_fingerprint", fingerprint),
 	)
 	if len(dg) > 0 {
 		return dg
 	}
 
-	d.SetId(webhookEndpoint.ID)
 	return resourceStripeWebhookEndpointRead(ctx, d, m)
 }
 
@@ -125,7 +225,7 @@ func resourceStripeWebhookEndpointUpdate(ctx context.Context, d *schema.Resource
 	params := &stripe.WebhookEndpointParams{}
 
 	if d.HasChange("enabled_events") {
-		params.EnabledEvents = stripe.StringSlice(StringSlice(d, "enabled_events"))
+		params.EnabledEvents = stripe.StringSlice(SetSlice(d, "enabled_events"))
 	}
 
 	if d.HasChange("url") {
@@ -167,3 +267,46 @@ func resourceStripeWebhookEndpointDelete(_ context.Context, d *schema.ResourceDa
 	d.SetId("")
 	return nil
 }
+
+func resourceStripeWebhookEndpointResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled_events": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceStripeWebhookEndpointStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	return rawState, nil
+}
@@ -0,0 +1,205 @@
+package stripe
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/client"
+)
+
+var endpointLocks sync.Map // map[string]*sync.Mutex
+
+func lockEndpoint(endpointID string) func() {
+	muAny, _ := endpointLocks.LoadOrStore(endpointID, &sync.Mutex{})
+	mu := muAny.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+func resourceStripeWebhookEndpointEventSubscription() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceStripeWebhookEndpointEventSubscriptionRead,
+		CreateContext: resourceStripeWebhookEndpointEventSubscriptionCreate,
+		UpdateContext: resourceStripeWebhookEndpointEventSubscriptionUpdate,
+		DeleteContext: resourceStripeWebhookEndpointEventSubscriptionDelete,
+		Schema: map[string]*schema.Schema{
+			"endpoint_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the stripe_webhook_endpoint this subscription contributes events to.",
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Description: "The events this subscription owns on the parent endpoint. Merged into the endpoint's " +
+					"enabled_events alongside any other subscriptions targeting the same endpoint_id.",
+			},
+		},
+	}
+}
+
+func resourceStripeWebhookEndpointEventSubscriptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	endpointID := String(d, "endpoint_id")
+
+	unlock := lockEndpoint(endpointID)
+	defer unlock()
+
+	if err := mergeEndpointEvents(m, endpointID, SetSlice(d, "events")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	suffix, err := randomHex(8)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", endpointID, suffix))
+
+	return resourceStripeWebhookEndpointEventSubscriptionRead(ctx, d, m)
+}
+
+func resourceStripeWebhookEndpointEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	endpointID := String(d, "endpoint_id")
+
+	unlock := lockEndpoint(endpointID)
+	defer unlock()
+
+	if d.HasChange("events") {
+		old, new := d.GetChange("events")
+		dropped := old.(*schema.Set).Difference(new.(*schema.Set))
+
+		if err := removeEndpointEvents(m, endpointID, setStrings(dropped)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := mergeEndpointEvents(m, endpointID, SetSlice(d, "events")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceStripeWebhookEndpointEventSubscriptionRead(ctx, d, m)
+}
+
+func resourceStripeWebhookEndpointEventSubscriptionRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.API)
+	endpointID := String(d, "endpoint_id")
+
+	webhookEndpoint, err := c.WebhookEndpoints.Get(endpointID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	enabled := make(map[string]bool, len(webhookEndpoint.EnabledEvents))
+	for _, e := range webhookEndpoint.EnabledEvents {
+		enabled[e] = true
+	}
+
+	owned := make([]string, 0, len(SetSlice(d, "events")))
+	for _, e := range SetSlice(d, "events") {
+		if enabled[e] {
+			owned = append(owned, e)
+		}
+	}
+
+	if len(owned) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	return CallSet(d.Set("events", owned))
+}
+
+func resourceStripeWebhookEndpointEventSubscriptionDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	endpointID := String(d, "endpoint_id")
+
+	unlock := lockEndpoint(endpointID)
+	defer unlock()
+
+	if err := removeEndpointEvents(m, endpointID, SetSlice(d, "events")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func mergeEndpointEvents(m interface{}, endpointID string, events []string) error {
+	c := m.(*client.API)
+
+	webhookEndpoint, err := c.WebhookEndpoints.Get(endpointID, nil)
+	if err != nil {
+		return err
+	}
+
+	union := make(map[string]bool, len(webhookEndpoint.EnabledEvents)+len(events))
+	for _, e := range webhookEndpoint.EnabledEvents {
+		union[e] = true
+	}
+	for _, e := range events {
+		union[e] = true
+	}
+
+	_, err = c.WebhookEndpoints.Update(endpointID, &stripe.WebhookEndpointParams{
+		EnabledEvents: stripe.StringSlice(mapKeysToSlice(union)),
+	})
+	return err
+}
+
+func removeEndpointEvents(m interface{}, endpointID string, events []string) error {
+	c := m.(*client.API)
+
+	webhookEndpoint, err := c.WebhookEndpoints.Get(endpointID, nil)
+	if err != nil {
+		return err
+	}
+
+	remove := make(map[string]bool, len(events))
+	for _, e := range events {
+		remove[e] = true
+	}
+
+	remaining := make([]string, 0, len(webhookEndpoint.EnabledEvents))
+	for _, e := range webhookEndpoint.EnabledEvents {
+		if !remove[e] {
+			remaining = append(remaining, e)
+		}
+	}
+
+	_, err = c.WebhookEndpoints.Update(endpointID, &stripe.WebhookEndpointParams{
+		EnabledEvents: stripe.StringSlice(remaining),
+	})
+	return err
+}
+
+func mapKeysToSlice(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func setStrings(s *schema.Set) []string {
+	list := s.List()
+	out := make([]string, 0, len(list))
+	for _, v := range list {
+		out = append(out, v.(string))
+	}
+	return out
+}
+
+func randomHex(n int) (string, error) {
+	raw := make([]byte, n)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generating random suffix: %w", err)
+	}
+	return hex.EncodeToString(raw), nil
+}
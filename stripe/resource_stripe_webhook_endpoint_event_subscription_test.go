@@ -0,0 +1,95 @@
+package stripe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stripe/stripe-go/v72/client"
+)
+
+func TestAccStripeWebhookEndpointEventSubscription_concurrent(t *testing.T) {
+	endpointRes := "stripe_webhook_endpoint.test"
+	subA := "stripe_webhook_endpoint_event_subscription.a"
+	subB := "stripe_webhook_endpoint_event_subscription.b"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStripeWebhookEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Two sibling subscriptions with no dependency between them are
+				// applied concurrently by Terraform's own graph walker, which is
+				// what exercises the per-endpoint mutex held around mergeEndpointEvents.
+				Config: testAccStripeWebhookEndpointEventSubscriptionConcurrentConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(endpointRes, "id"),
+					resource.TestCheckResourceAttr(subA, "events.#", "1"),
+					resource.TestCheckResourceAttr(subB, "events.#", "1"),
+					testAccCheckStripeWebhookEndpointHasEvents(endpointRes, []string{"charge.succeeded", "charge.failed"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccStripeWebhookEndpointEventSubscriptionConcurrentConfig() string {
+	return `
+resource "stripe_webhook_endpoint" "test" {
+  url                   = "https://example.com/webhooks/test"
+  enabled_events        = []
+  manage_enabled_events = false
+}
+
+resource "stripe_webhook_endpoint_event_subscription" "a" {
+  endpoint_id = stripe_webhook_endpoint.test.id
+  events      = ["charge.succeeded"]
+}
+
+resource "stripe_webhook_endpoint_event_subscription" "b" {
+  endpoint_id = stripe_webhook_endpoint.test.id
+  events      = ["charge.failed"]
+}
+`
+}
+
+func testAccCheckStripeWebhookEndpointHasEvents(n string, want []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		c := testAccProvider.Meta().(*client.API)
+		webhookEndpoint, err := c.WebhookEndpoints.Get(rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		got := make(map[string]bool, len(webhookEndpoint.EnabledEvents))
+		for _, e := range webhookEndpoint.EnabledEvents {
+			got[e] = true
+		}
+		for _, e := range want {
+			if !got[e] {
+				return fmt.Errorf("expected event %q to be enabled on %s, got %v", e, n, webhookEndpoint.EnabledEvents)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckStripeWebhookEndpointDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.API)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "stripe_webhook_endpoint" {
+			continue
+		}
+		if _, err := c.WebhookEndpoints.Get(rs.Primary.ID, nil); err == nil {
+			return fmt.Errorf("webhook endpoint %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
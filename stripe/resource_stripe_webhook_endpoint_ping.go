@@ -0,0 +1,163 @@
+package stripe
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceStripeWebhookEndpointPing() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStripeWebhookEndpointPingCreate,
+		ReadContext:   resourceStripeWebhookEndpointPingRead,
+		DeleteContext: resourceStripeWebhookEndpointPingDelete,
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URL of the webhook endpoint to ping, typically the `url` output of a `stripe_webhook_endpoint`.",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The signing secret used to compute the `Stripe-Signature` header, typically the `secret` output of a `stripe_webhook_endpoint`.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ping",
+				Description: "The `type` field of the synthetic event sent to the endpoint.",
+			},
+			"fail_on_non_2xx": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether a non-2xx response from the endpoint should fail the apply.",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     10,
+				Description: "How long to wait for the endpoint to respond, in seconds.",
+			},
+			"status_code": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The HTTP status code returned by the endpoint.",
+			},
+			"response_body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The response body returned by the endpoint.",
+			},
+			"latency_ms": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How long the endpoint took to respond, in milliseconds.",
+			},
+		},
+	}
+}
+
+func resourceStripeWebhookEndpointPingCreate(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	url := String(d, "url")
+	secret := String(d, "secret")
+	eventType := String(d, "type")
+
+	eventID, err := randomEventID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"id":     eventID,
+		"object": "event",
+		"type":   eventType,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timestamp := time.Now().Unix()
+	signature := stripeSignature(timestamp, payload, secret)
+
+	httpClient := &http.Client{Timeout: time.Duration(Int(d, "timeout")) * time.Second}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Stripe-Signature", signature)
+
+	start := time.Now()
+	resp, err := httpClient.Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(eventID)
+
+	dg := CallSet(
+		d.Set("status_code", resp.StatusCode),
+		d.Set("response_body", string(body)),
+		d.Set("latency_ms", latency.Milliseconds()),
+	)
+	if len(dg) > 0 {
+		return dg
+	}
+
+	if Bool(d, "fail_on_non_2xx") && (resp.StatusCode < 200 || resp.StatusCode >= 300) {
+		return diag.FromErr(fmt.Errorf("webhook endpoint %s responded with non-2xx status %d: %s", url, resp.StatusCode, body))
+	}
+
+	return nil
+}
+
+func resourceStripeWebhookEndpointPingRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceStripeWebhookEndpointPingDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func stripeSignature(timestamp int64, payload []byte, secret string) string {
+	signedPayload := fmt.Sprintf("%d.%s", timestamp, payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signedPayload))
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func randomEventID() (string, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generating event id: %w", err)
+	}
+	return "evt_" + hex.EncodeToString(raw), nil
+}
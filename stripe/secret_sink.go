@@ -0,0 +1,44 @@
+package stripe
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+const (
+	secretSinkTypeEnv  = "env"
+	secretSinkTypeFile = "file"
+	secretSinkTypeExec = "exec"
+)
+
+func writeSecretSink(sinkType, target, secret string) (string, error) {
+	switch sinkType {
+	case secretSinkTypeEnv:
+		if err := os.Setenv(target, secret); err != nil {
+			return "", fmt.Errorf("setting env var %q: %w", target, err)
+		}
+	case secretSinkTypeFile:
+		if err := os.WriteFile(target, []byte(secret), 0600); err != nil {
+			return "", fmt.Errorf("writing secret file %q: %w", target, err)
+		}
+	case secretSinkTypeExec:
+		cmd := exec.Command(target)
+		cmd.Stdin = bytes.NewBufferString(secret)
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("running secret sink exec target %q: %w", target, err)
+		}
+	default:
+		return "", fmt.Errorf("unknown secret_sink type %q", sinkType)
+	}
+
+	return secretFingerprint(secret), nil
+}
+
+func secretFingerprint(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}